@@ -1,6 +1,7 @@
 package cosmos
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -9,7 +10,7 @@ import (
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/cosmos/migration"
 
-	"github.com/Azure/azure-sdk-for-go/services/preview/cosmos-db/mgmt/2020-04-01-preview/documentdb"
+	"github.com/Azure/azure-sdk-for-go/services/preview/cosmos-db/mgmt/2021-04-15/documentdb"
 	"github.com/hashicorp/go-azure-helpers/response"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
@@ -147,9 +148,8 @@ func resourceCosmosDbMongoDatabaseUpdate(d *schema.ResourceData, meta interface{
 		return err
 	}
 
-	err = common.CheckForChangeFromAutoscaleAndManualThroughput(d)
-	if err != nil {
-		return fmt.Errorf("Error updating Cosmos Mongo Database %q (Account: %q) - %+v", id.Name, id.DatabaseAccountName, err)
+	if err := migrateCosmosDbMongoDatabaseThroughputMode(ctx, client, id, d); err != nil {
+		return fmt.Errorf("Error migrating throughput mode for Cosmos Mongo Database %q (Account: %q): %+v", id.Name, id.DatabaseAccountName, err)
 	}
 
 	db := documentdb.MongoDBDatabaseCreateUpdateParameters{
@@ -192,6 +192,47 @@ func resourceCosmosDbMongoDatabaseUpdate(d *schema.ResourceData, meta interface{
 	return resourceCosmosDbMongoDatabaseRead(d, meta)
 }
 
+// migrateCosmosDbMongoDatabaseThroughputMode handles the transition between manual and
+// autoscale throughput in-place, before the Update path applies the new throughput or
+// autoscale value within the target mode.
+//
+// TODO: the Cassandra/SQL/Gremlin/Table and Mongo-collection resources share the same
+// common.CheckForChangeFromAutoscaleAndManualThroughput guard and need this same
+// migrate-in-place treatment. None of those resource files exist in this tree yet
+// (only cosmosdb_mongo_database_resource.go does), so that work is an explicit
+// follow-up rather than part of this change.
+func migrateCosmosDbMongoDatabaseThroughputMode(ctx context.Context, client *documentdb.MongoDBResourcesClient, id *parse.MongodbDatabaseId, d *schema.ResourceData) error {
+	oldThroughput, newThroughput := d.GetChange("throughput")
+	oldAutoscaleSettings, newAutoscaleSettings := d.GetChange("autoscale_settings")
+
+	hadThroughput := oldThroughput.(int) > 0
+	hasThroughput := newThroughput.(int) > 0
+	hadAutoscaleSettings := len(oldAutoscaleSettings.([]interface{})) > 0
+	hasAutoscaleSettings := len(newAutoscaleSettings.([]interface{})) > 0
+
+	switch {
+	case hadThroughput && !hadAutoscaleSettings && hasAutoscaleSettings:
+		future, err := client.MigrateMongoDBDatabaseToAutoscale(ctx, id.ResourceGroup, id.DatabaseAccountName, id.Name)
+		if err != nil {
+			return fmt.Errorf("issuing migrate to autoscale request: %+v", err)
+		}
+		if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("waiting for migrate to autoscale: %+v", err)
+		}
+
+	case hadAutoscaleSettings && !hasAutoscaleSettings && hasThroughput:
+		future, err := client.MigrateMongoDBDatabaseToManualThroughput(ctx, id.ResourceGroup, id.DatabaseAccountName, id.Name)
+		if err != nil {
+			return fmt.Errorf("issuing migrate to manual throughput request: %+v", err)
+		}
+		if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("waiting for migrate to manual throughput: %+v", err)
+		}
+	}
+
+	return nil
+}
+
 func resourceCosmosDbMongoDatabaseRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Cosmos.MongoDbClient
 	accountClient := meta.(*clients.Client).Cosmos.DatabaseClient